@@ -16,6 +16,7 @@ import (
 	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -55,8 +56,16 @@ type Config struct {
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
+
 	DynamoDBEndpoint string
 	KinesisEndpoint  string
+	RdsEndpoint      string
+
+	UserAgentSuffix string
+
+	RdsRetryableErrorCodes []string
 }
 
 type AWSClient struct {
@@ -77,6 +86,7 @@ type AWSClient struct {
 	snsconn            *sns.SNS
 	r53conn            *route53.Route53
 	region             string
+	ignoreTags         *tagIgnoreConfig
 	rdsconn            *rds.RDS
 	iamconn            *iam.IAM
 	kinesisconn        *kinesis.Kinesis
@@ -108,6 +118,13 @@ func (c *Config) Client() (interface{}, error) {
 		// bucket storage in S3
 		client.region = c.Region
 
+		if len(c.IgnoreTagKeys) > 0 || len(c.IgnoreTagKeyPrefixes) > 0 {
+			client.ignoreTags = &tagIgnoreConfig{
+				Keys:        c.IgnoreTagKeys,
+				KeyPrefixes: c.IgnoreTagKeyPrefixes,
+			}
+		}
+
 		log.Println("[INFO] Building AWS auth structure")
 		creds := getCreds(c.AccessKey, c.SecretKey, c.Token)
 		// Call Get to check for credential provider. If nothing found, we'll get an
@@ -117,15 +134,10 @@ func (c *Config) Client() (interface{}, error) {
 			errs = append(errs, fmt.Errorf("Error loading credentials for AWS Provider: %s", err))
 			return nil, &multierror.Error{Errors: errs}
 		}
-		awsConfig := &aws.Config{
-			Credentials: creds,
-			Region:      aws.String(c.Region),
-			MaxRetries:  aws.Int(c.MaxRetries),
-			HTTPClient:  cleanhttp.DefaultClient(),
-		}
+		awsConfig := c.awsConfig(creds)
 
 		log.Println("[INFO] Initializing IAM Connection")
-		sess := session.New(awsConfig)
+		sess := c.newSession(awsConfig)
 		client.iamconn = iam.New(sess)
 
 		err = c.ValidateCredentials(client.iamconn)
@@ -144,13 +156,13 @@ func (c *Config) Client() (interface{}, error) {
 			MaxRetries:  aws.Int(c.MaxRetries),
 			HTTPClient:  cleanhttp.DefaultClient(),
 		}
-		usEast1Sess := session.New(usEast1AwsConfig)
+		usEast1Sess := c.newSession(usEast1AwsConfig)
 
 		awsDynamoDBConfig := *awsConfig
 		awsDynamoDBConfig.Endpoint = aws.String(c.DynamoDBEndpoint)
 
 		log.Println("[INFO] Initializing DynamoDB connection")
-		dynamoSess := session.New(&awsDynamoDBConfig)
+		dynamoSess := c.newSession(&awsDynamoDBConfig)
 		client.dynamodbconn = dynamodb.New(dynamoSess)
 
 		log.Println("[INFO] Initializing ELB connection")
@@ -166,13 +178,13 @@ func (c *Config) Client() (interface{}, error) {
 		client.snsconn = sns.New(sess)
 
 		log.Println("[INFO] Initializing RDS Connection")
-		client.rdsconn = rds.New(sess)
+		client.rdsconn = c.rdsConn(awsConfig)
 
 		awsKinesisConfig := *awsConfig
 		awsKinesisConfig.Endpoint = aws.String(c.KinesisEndpoint)
 
 		log.Println("[INFO] Initializing Kinesis Connection")
-		kinesisSess := session.New(&awsKinesisConfig)
+		kinesisSess := c.newSession(&awsKinesisConfig)
 		client.kinesisconn = kinesis.New(kinesisSess)
 
 		authErr := c.ValidateAccountId(client.iamconn)
@@ -242,6 +254,73 @@ func (c *Config) Client() (interface{}, error) {
 	return &client, nil
 }
 
+// awsConfig returns the aws.Config shared by the service connections,
+// built from the provider's region and max_retries settings.
+func (c *Config) awsConfig(creds *awsCredentials.Credentials) *aws.Config {
+	return &aws.Config{
+		Credentials: creds,
+		Region:      aws.String(c.Region),
+		MaxRetries:  aws.Int(c.MaxRetries),
+		HTTPClient:  cleanhttp.DefaultClient(),
+	}
+}
+
+// newSession returns a session for awsConfig whose clients append the
+// user_agent_suffix, if set, to the User-Agent of every request.
+func (c *Config) newSession(awsConfig *aws.Config) *session.Session {
+	sess := session.New(awsConfig)
+	if c.UserAgentSuffix != "" {
+		sess.Handlers.Build.PushBack(userAgentSuffixHandler(c.UserAgentSuffix))
+	}
+
+	return sess
+}
+
+// rdsConn returns an RDS connection built from awsConfig, honoring the
+// endpoint override from the provider's endpoints block if it was set.
+func (c *Config) rdsConn(awsConfig *aws.Config) *rds.RDS {
+	awsRdsConfig := *awsConfig
+	if c.RdsEndpoint != "" {
+		awsRdsConfig.Endpoint = aws.String(c.RdsEndpoint)
+	}
+
+	conn := rds.New(c.newSession(&awsRdsConfig))
+	if len(c.RdsRetryableErrorCodes) > 0 {
+		conn.Handlers.Retry.PushBack(retryableErrorCodesHandler(c.RdsRetryableErrorCodes))
+	}
+
+	return conn
+}
+
+// userAgentSuffixHandler returns a request handler that appends suffix to
+// the User-Agent header, so calls can be attributed in CloudTrail.
+func userAgentSuffixHandler(suffix string) func(*request.Request) {
+	return func(r *request.Request) {
+		ua := r.HTTPRequest.Header.Get("User-Agent")
+		r.HTTPRequest.Header.Set("User-Agent", strings.TrimSpace(ua+" "+suffix))
+	}
+}
+
+// retryableErrorCodesHandler returns a request handler that marks errors with
+// any of the given codes as retryable, on top of the SDK's own throttling and
+// transient error classification. The request's MaxRetries still applies.
+func retryableErrorCodesHandler(codes []string) func(*request.Request) {
+	return func(r *request.Request) {
+		awsErr, ok := r.Error.(awserr.Error)
+		if !ok {
+			return
+		}
+
+		for _, code := range codes {
+			if awsErr.Code() == code {
+				log.Printf("[DEBUG] Retrying %s on configured retryable error: %s", r.Operation.Name, awsErr.Code())
+				r.Retryable = aws.Bool(true)
+				return
+			}
+		}
+	}
+}
+
 // ValidateRegion returns an error if the configured region is not a
 // valid aws region and nil otherwise.
 func (c *Config) ValidateRegion() error {
@@ -6,9 +6,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/rds"
 )
 
 func TestAWSConfig_shouldError(t *testing.T) {
@@ -69,6 +73,162 @@ func TestAWSConfig_shouldBeStatic(t *testing.T) {
 	}
 }
 
+func TestAWSConfig_rdsEndpoint(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintln(w, rdsDescribeDBInstancesEmptyResponse)
+	}))
+	defer ts.Close()
+
+	cfg := Config{
+		AccessKey:   "test",
+		SecretKey:   "test",
+		Region:      "us-west-2",
+		RdsEndpoint: ts.URL,
+	}
+
+	conn := cfg.rdsConn(cfg.awsConfig(getCreds(cfg.AccessKey, cfg.SecretKey, cfg.Token)))
+
+	if _, err := conn.DescribeDBInstances(&rds.DescribeDBInstancesInput{}); err != nil {
+		t.Fatalf("Error calling stub RDS endpoint: %s", err)
+	}
+	if requests != 1 {
+		t.Fatalf("Expected 1 request to the stub RDS endpoint, got %d", requests)
+	}
+}
+
+func TestAWSConfig_rdsMaxRetries(t *testing.T) {
+	for _, endpoint := range []string{"", "http://localhost:4566"} {
+		cfg := Config{
+			AccessKey:   "test",
+			SecretKey:   "test",
+			Region:      "us-west-2",
+			MaxRetries:  25,
+			RdsEndpoint: endpoint,
+		}
+
+		conn := cfg.rdsConn(cfg.awsConfig(getCreds(cfg.AccessKey, cfg.SecretKey, cfg.Token)))
+
+		if conn.Config.MaxRetries == nil || *conn.Config.MaxRetries != cfg.MaxRetries {
+			t.Fatalf("Expected RDS client MaxRetries to be %d (endpoint %q), got %#v",
+				cfg.MaxRetries, endpoint, conn.Config.MaxRetries)
+		}
+	}
+}
+
+func TestAWSConfig_rdsUserAgentSuffix(t *testing.T) {
+	var userAgent string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintln(w, rdsDescribeDBInstancesEmptyResponse)
+	}))
+	defer ts.Close()
+
+	cfg := Config{
+		AccessKey:       "test",
+		SecretKey:       "test",
+		Region:          "us-west-2",
+		RdsEndpoint:     ts.URL,
+		UserAgentSuffix: "deploy-pipeline/42",
+	}
+
+	conn := cfg.rdsConn(cfg.awsConfig(getCreds(cfg.AccessKey, cfg.SecretKey, cfg.Token)))
+
+	if _, err := conn.DescribeDBInstances(&rds.DescribeDBInstancesInput{}); err != nil {
+		t.Fatalf("Error calling stub RDS endpoint: %s", err)
+	}
+	if !strings.HasSuffix(userAgent, " deploy-pipeline/42") {
+		t.Fatalf("Expected User-Agent to end with the configured suffix, got %q", userAgent)
+	}
+}
+
+func TestAWSConfig_userAgentSuffix(t *testing.T) {
+	var userAgent string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	cfg := Config{
+		AccessKey:       "test",
+		SecretKey:       "test",
+		Region:          "us-west-2",
+		UserAgentSuffix: "deploy-pipeline/42",
+	}
+
+	awsConfig := cfg.awsConfig(getCreds(cfg.AccessKey, cfg.SecretKey, cfg.Token))
+	awsConfig.Endpoint = aws.String(ts.URL)
+	awsConfig.MaxRetries = aws.Int(0)
+
+	// The response is irrelevant, only the request's User-Agent is checked
+	iam.New(cfg.newSession(awsConfig)).GetUser(&iam.GetUserInput{})
+
+	if !strings.HasSuffix(userAgent, " deploy-pipeline/42") {
+		t.Fatalf("Expected User-Agent of non-RDS clients to end with the configured suffix, got %q", userAgent)
+	}
+}
+
+func TestAWSConfig_rdsRetryableErrorCodes(t *testing.T) {
+	cases := []struct {
+		Codes    []string
+		Requests int
+	}{
+		{
+			Codes:    nil,
+			Requests: 1,
+		},
+		{
+			Codes:    []string{"ExampleThrottlingException"},
+			Requests: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		var requests int
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Content-Type", "text/xml")
+			if requests == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintln(w, rdsExampleThrottlingErrorResponse)
+				return
+			}
+			fmt.Fprintln(w, rdsDescribeDBInstancesEmptyResponse)
+		}))
+
+		cfg := Config{
+			AccessKey:              "test",
+			SecretKey:              "test",
+			Region:                 "us-west-2",
+			RdsEndpoint:            ts.URL,
+			RdsRetryableErrorCodes: tc.Codes,
+		}
+
+		conn := cfg.rdsConn(&aws.Config{
+			Credentials: getCreds(cfg.AccessKey, cfg.SecretKey, cfg.Token),
+			Region:      aws.String(cfg.Region),
+			MaxRetries:  aws.Int(1),
+		})
+
+		_, err := conn.DescribeDBInstances(&rds.DescribeDBInstancesInput{})
+		ts.Close()
+
+		if tc.Requests == 1 && err == nil {
+			t.Fatalf("Expected ExampleThrottlingException without it being configured as retryable")
+		}
+		if tc.Requests > 1 && err != nil {
+			t.Fatalf("Expected retry on configured error code %v, got: %s", tc.Codes, err)
+		}
+		if requests != tc.Requests {
+			t.Fatalf("Expected %d requests with retryable codes %v, got %d", tc.Requests, tc.Codes, requests)
+		}
+	}
+}
+
 // TestAWSConfig_shouldIAM is designed to test the scenario of running Terraform
 // from an EC2 instance, without environment variables or manually supplied
 // credentials.
@@ -297,3 +457,23 @@ const aws_routes = `
   ]
 }
 `
+
+const rdsDescribeDBInstancesEmptyResponse = `<DescribeDBInstancesResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <DescribeDBInstancesResult>
+    <DBInstances/>
+  </DescribeDBInstancesResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</DescribeDBInstancesResponse>
+`
+
+const rdsExampleThrottlingErrorResponse = `<ErrorResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>ExampleThrottlingException</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>
+`
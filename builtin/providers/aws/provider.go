@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -87,6 +89,60 @@ func Provider() terraform.ResourceProvider {
 				Default:     "",
 				Description: descriptions["kinesis_endpoint"],
 			},
+
+			"user_agent_suffix": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: descriptions["user_agent_suffix"],
+			},
+
+			"rds_retryable_error_codes": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: descriptions["rds_retryable_error_codes"],
+			},
+
+			"ignore_tags": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": &schema.Schema{
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: descriptions["ignore_tags_keys"],
+						},
+
+						"key_prefixes": &schema.Schema{
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: descriptions["ignore_tags_key_prefixes"],
+						},
+					},
+				},
+			},
+
+			"endpoints": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rds": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: descriptions["rds_endpoint"],
+						},
+					},
+				},
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -227,6 +283,21 @@ func init() {
 
 		"kinesis_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to kinesalite.",
+
+		"user_agent_suffix": "A string appended to the User-Agent of AWS API requests, e.g. to\n" +
+			"identify the automation making a change in CloudTrail.",
+
+		"rds_retryable_error_codes": "Additional RDS API error codes to treat as transient and retry,\n" +
+			"e.g. throttling codes that aren't known to the provider yet.",
+
+		"ignore_tags_keys": "Tag keys that are managed outside of Terraform. They are not\n" +
+			"read into state and never added or removed. Currently applies to RDS resources.",
+
+		"ignore_tags_key_prefixes": "Tag key prefixes that are managed outside of Terraform. Matching\n" +
+			"tags are not read into state and never added or removed. Currently applies to RDS resources.",
+
+		"rds_endpoint": "Use this to override the default RDS endpoint URL constructed from the `region`.\n" +
+			"It's typically used to connect to a mock service or a VPC endpoint.",
 	}
 }
 
@@ -239,6 +310,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		MaxRetries:       d.Get("max_retries").(int),
 		DynamoDBEndpoint: d.Get("dynamodb_endpoint").(string),
 		KinesisEndpoint:  d.Get("kinesis_endpoint").(string),
+		UserAgentSuffix:  d.Get("user_agent_suffix").(string),
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -249,6 +321,33 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.ForbiddenAccountIds = v.(*schema.Set).List()
 	}
 
+	if v, ok := d.GetOk("rds_retryable_error_codes"); ok {
+		for _, c := range v.(*schema.Set).List() {
+			config.RdsRetryableErrorCodes = append(config.RdsRetryableErrorCodes, c.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("ignore_tags"); ok {
+		for _, i := range v.([]interface{}) {
+			ignore := i.(map[string]interface{})
+			for _, k := range ignore["keys"].(*schema.Set).List() {
+				config.IgnoreTagKeys = append(config.IgnoreTagKeys, k.(string))
+			}
+			for _, p := range ignore["key_prefixes"].(*schema.Set).List() {
+				config.IgnoreTagKeyPrefixes = append(config.IgnoreTagKeyPrefixes, p.(string))
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("endpoints"); ok {
+		endpointsList := v.([]interface{})
+		if len(endpointsList) > 1 {
+			return nil, fmt.Errorf("Only one endpoints block is allowed, got %d", len(endpointsList))
+		}
+		endpoints := endpointsList[0].(map[string]interface{})
+		config.RdsEndpoint = endpoints["rds"].(string)
+	}
+
 	return config.Client()
 }
 
@@ -55,6 +55,7 @@ func resourceAwsDbInstance() *schema.Resource {
 					value := v.(string)
 					return strings.ToLower(value)
 				},
+				ValidateFunc: validateRdsEngine,
 			},
 
 			"engine_version": &schema.Schema{
@@ -63,12 +64,26 @@ func resourceAwsDbInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"character_set_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"storage_encrypted": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
 			},
 
+			"kms_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"allocated_storage": &schema.Schema{
 				Type:     schema.TypeInt,
 				Required: true,
@@ -117,9 +132,10 @@ func resourceAwsDbInstance() *schema.Resource {
 			},
 
 			"license_model": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateRdsLicenseModel,
 			},
 
 			"maintenance_window": &schema.Schema{
@@ -188,6 +204,11 @@ func resourceAwsDbInstance() *schema.Resource {
 				},
 			},
 
+			"final_snapshot_tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
 			"skip_final_snapshot": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -213,6 +234,12 @@ func resourceAwsDbInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"option_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
 			"address": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -255,6 +282,35 @@ func resourceAwsDbInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"restore_to_point_in_time": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_identifier", "replicate_source_db"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_db_instance_identifier": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"restore_time": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateRdsRestoreTime,
+						},
+
+						"use_latest_restorable_time": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"auto_minor_version_upgrade": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -274,7 +330,12 @@ func resourceAwsDbInstance() *schema.Resource {
 
 func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}), meta.(*AWSClient).ignoreTags)
+
+	if err := validateDbInstanceMultiAZ(
+		d.Get("multi_az").(bool), d.Get("availability_zone").(string)); err != nil {
+		return err
+	}
 
 	if v, ok := d.GetOk("replicate_source_db"); ok {
 		opts := rds.CreateDBInstanceReadReplicaInput{
@@ -282,6 +343,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			CopyTagsToSnapshot:         aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 			DBInstanceClass:            aws.String(d.Get("instance_class").(string)),
 			DBInstanceIdentifier:       aws.String(d.Get("identifier").(string)),
+			AutoMinorVersionUpgrade:    aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
 			Tags:                       tags,
 		}
 		if attr, ok := d.GetOk("iops"); ok {
@@ -300,7 +362,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			opts.StorageType = aws.String(attr.(string))
 		}
 
-		if attr, ok := d.GetOk("publicly_accessible"); ok {
+		if attr, ok := d.GetOkExists("publicly_accessible"); ok {
 			opts.PubliclyAccessible = aws.Bool(attr.(bool))
 		}
 
@@ -354,7 +416,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			opts.Port = aws.Int64(int64(attr.(int)))
 		}
 
-		if attr, ok := d.GetOk("publicly_accessible"); ok {
+		if attr, ok := d.GetOkExists("publicly_accessible"); ok {
 			opts.PubliclyAccessible = aws.Bool(attr.(bool))
 		}
 
@@ -373,37 +435,124 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 
 		if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
 			log.Printf("[INFO] DB is restoring from snapshot with default security, but custom security should be set, will now update after snapshot is restored!")
+			if err := resourceAwsDbInstanceUpdateAfterRestore(d, meta); err != nil {
+				return err
+			}
+		}
+	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
+		restore := v.([]interface{})
+		if len(restore) != 1 {
+			return fmt.Errorf("Only one restore_to_point_in_time block is allowed, got %d", len(restore))
+		}
+		pitr := restore[0].(map[string]interface{})
 
-			// wait for instance to get up and then modify security
-			d.SetId(d.Get("identifier").(string))
+		if err := validateDbInstanceRestoreToPointInTime(
+			pitr["restore_time"].(string), pitr["use_latest_restorable_time"].(bool)); err != nil {
+			return err
+		}
 
-			log.Printf("[INFO] DB Instance ID: %s", d.Id())
+		opts := rds.RestoreDBInstanceToPointInTimeInput{
+			SourceDBInstanceIdentifier: aws.String(pitr["source_db_instance_identifier"].(string)),
+			TargetDBInstanceIdentifier: aws.String(d.Get("identifier").(string)),
+			DBInstanceClass:            aws.String(d.Get("instance_class").(string)),
+			AutoMinorVersionUpgrade:    aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+			CopyTagsToSnapshot:         aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
+			Tags:                       tags,
+		}
 
-			log.Println(
-				"[INFO] Waiting for DB Instance to be available")
+		if attr := pitr["restore_time"].(string); attr != "" {
+			// Already checked by validateRdsRestoreTime
+			t, _ := time.Parse(time.RFC3339, attr)
+			opts.RestoreTime = aws.Time(t)
+		} else {
+			opts.UseLatestRestorableTime = aws.Bool(true)
+		}
 
-			stateConf := &resource.StateChangeConf{
-				Pending:    []string{"creating", "backing-up", "modifying"},
-				Target:     "available",
-				Refresh:    resourceAwsDbInstanceStateRefreshFunc(d, meta),
-				Timeout:    40 * time.Minute,
-				MinTimeout: 10 * time.Second,
-				Delay:      30 * time.Second, // Wait 30 secs before starting
-			}
+		if attr, ok := d.GetOk("availability_zone"); ok {
+			opts.AvailabilityZone = aws.String(attr.(string))
+		}
 
-			// Wait, catching any errors
-			_, err := stateConf.WaitForState()
-			if err != nil {
+		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
+			opts.DBSubnetGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("engine"); ok {
+			opts.Engine = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("iops"); ok {
+			opts.Iops = aws.Int64(int64(attr.(int)))
+		}
+
+		if attr, ok := d.GetOk("license_model"); ok {
+			opts.LicenseModel = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("multi_az"); ok {
+			opts.MultiAZ = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("option_group_name"); ok {
+			opts.OptionGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("port"); ok {
+			opts.Port = aws.Int64(int64(attr.(int)))
+		}
+
+		if attr, ok := d.GetOkExists("publicly_accessible"); ok {
+			opts.PubliclyAccessible = aws.Bool(attr.(bool))
+		}
+
+		if attr, ok := d.GetOk("storage_type"); ok {
+			opts.StorageType = aws.String(attr.(string))
+		}
+
+		log.Printf("[DEBUG] DB Instance point in time restore configuration: %#v", opts)
+		_, err := conn.RestoreDBInstanceToPointInTime(&opts)
+		if err != nil {
+			return fmt.Errorf("Error creating DB Instance: %s", err)
+		}
+
+		// The restored instance keeps the source's password, storage, security
+		// groups and other settings, so apply the configured ones on top.
+		if err := resourceAwsDbInstanceUpdateAfterRestore(d, meta); err != nil {
+			return err
+		}
+	} else {
+		if err := validateDbInstanceStorage(
+			d.Get("storage_type").(string),
+			d.Get("iops").(int),
+			d.Get("allocated_storage").(int)); err != nil {
+			return err
+		}
+
+		if err := validateDbInstanceEncryption(
+			d.Get("storage_encrypted").(bool),
+			d.Get("kms_key_id").(string),
+			d.Get("instance_class").(string)); err != nil {
+			return err
+		}
+
+		if attr, ok := d.GetOk("license_model"); ok {
+			if err := checkRdsLicenseModel(d.Get("engine").(string), attr.(string)); err != nil {
 				return err
 			}
+		}
 
-			err = resourceAwsDbInstanceUpdate(d, meta)
-			if err != nil {
+		if attr, ok := d.GetOk("character_set_name"); ok {
+			if err := validateDbInstanceCharacterSet(d.Get("engine").(string), attr.(string)); err != nil {
 				return err
 			}
+		}
 
+		if attr, ok := d.GetOk("option_group_name"); ok {
+			if err := validateDbInstanceOptionGroup(
+				conn, d.Get("engine").(string), d.Get("engine_version").(string), attr.(string)); err != nil {
+				return err
+			}
 		}
-	} else {
+
 		opts := rds.CreateDBInstanceInput{
 			AllocatedStorage:        aws.Int64(int64(d.Get("allocated_storage").(int))),
 			DBName:                  aws.String(d.Get("name").(string)),
@@ -439,6 +588,18 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			opts.DBParameterGroupName = aws.String(attr.(string))
 		}
 
+		if attr, ok := d.GetOk("option_group_name"); ok {
+			opts.OptionGroupName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("character_set_name"); ok {
+			opts.CharacterSetName = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("kms_key_id"); ok {
+			opts.KmsKeyId = aws.String(attr.(string))
+		}
+
 		if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
 			var s []*string
 			for _, v := range attr.List() {
@@ -474,7 +635,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			opts.AvailabilityZone = aws.String(attr.(string))
 		}
 
-		if attr, ok := d.GetOk("publicly_accessible"); ok {
+		if attr, ok := d.GetOkExists("publicly_accessible"); ok {
 			opts.PubliclyAccessible = aws.Bool(attr.(bool))
 		}
 
@@ -511,6 +672,36 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	return resourceAwsDbInstanceRead(d, meta)
 }
 
+// resourceAwsDbInstanceUpdateAfterRestore waits for a restored instance to
+// become available and then applies the configured settings that the restore
+// APIs don't take, such as the password and VPC security groups.
+func resourceAwsDbInstanceUpdateAfterRestore(d *schema.ResourceData, meta interface{}) error {
+	// wait for instance to get up and then modify it
+	d.SetId(d.Get("identifier").(string))
+
+	log.Printf("[INFO] DB Instance ID: %s", d.Id())
+
+	log.Println(
+		"[INFO] Waiting for DB Instance to be available")
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "backing-up", "modifying"},
+		Target:     "available",
+		Refresh:    resourceAwsDbInstanceStateRefreshFunc(d, meta),
+		Timeout:    40 * time.Minute,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second, // Wait 30 secs before starting
+	}
+
+	// Wait, catching any errors
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsDbInstanceUpdate(d, meta)
+}
+
 func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	v, err := resourceAwsDbInstanceRetrieve(d, meta)
 
@@ -537,6 +728,7 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("license_model", v.LicenseModel)
 	d.Set("maintenance_window", v.PreferredMaintenanceWindow)
 	d.Set("multi_az", v.MultiAZ)
+	d.Set("publicly_accessible", v.PubliclyAccessible)
 	if v.DBSubnetGroup != nil {
 		d.Set("db_subnet_group_name", v.DBSubnetGroup.DBSubnetGroupName)
 	}
@@ -545,6 +737,10 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("parameter_group_name", v.DBParameterGroups[0].DBParameterGroupName)
 	}
 
+	if len(v.OptionGroupMemberships) > 0 {
+		d.Set("option_group_name", v.OptionGroupMemberships[0].OptionGroupName)
+	}
+
 	if v.Endpoint != nil {
 		d.Set("port", v.Endpoint.Port)
 		d.Set("address", v.Endpoint.Address)
@@ -557,6 +753,8 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("status", v.DBInstanceStatus)
 	d.Set("storage_encrypted", v.StorageEncrypted)
+	d.Set("kms_key_id", v.KmsKeyId)
+	d.Set("character_set_name", v.CharacterSetName)
 
 	// list tags for resource
 	// set tags
@@ -582,7 +780,7 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		d.Set("tags", tagsToMapRDS(dt, meta.(*AWSClient).ignoreTags))
 	}
 
 	// Create an empty schema.Set to hold all vpc security group ids
@@ -656,14 +854,102 @@ func resourceAwsDbInstanceDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if tags := d.Get("final_snapshot_tags").(map[string]interface{}); !skipFinalSnapshot && len(tags) > 0 {
+		// The instance is already gone, so failing here would leave it in state
+		// and the next destroy would fail. The snapshot itself is intact.
+		if err := tagDbInstanceFinalSnapshot(*opts.FinalDBSnapshotIdentifier, tags, meta); err != nil {
+			log.Printf("[WARN] DB Instance %s deleted, but final snapshot tags were not applied: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// tagDbInstanceFinalSnapshot waits for the final snapshot taken when a DB
+// Instance is deleted to become available, and then tags it. DeleteDBInstance
+// doesn't take tags for the snapshot itself.
+func tagDbInstanceFinalSnapshot(identifier string, tags map[string]interface{}, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	log.Printf("[INFO] Waiting for final DB Snapshot %s to be available", identifier)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  "available",
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{
+				DBSnapshotIdentifier: aws.String(identifier),
+			})
+			if err != nil {
+				if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "DBSnapshotNotFound" {
+					return nil, "creating", nil
+				}
+				return nil, "", err
+			}
+			if len(resp.DBSnapshots) == 0 {
+				return nil, "creating", nil
+			}
+
+			snapshot := resp.DBSnapshots[0]
+			return snapshot, *snapshot.Status, nil
+		},
+		Timeout:    20 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for final DB Snapshot %s: %s", identifier, err)
+	}
+
+	arn, err := buildRDSSnapshotARN(identifier, meta)
+	if err != nil {
+		return fmt.Errorf("Error building ARN for final DB Snapshot %s: %s", identifier, err)
+	}
+
+	if err := updateTagsRDS(conn, arn, map[string]interface{}{}, tags); err != nil {
+		return fmt.Errorf("Error tagging final DB Snapshot %s: %s", identifier, err)
+	}
+
 	return nil
 }
 
 func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
 
+	// Partial mode has to be on before any check can return an error, otherwise
+	// every pending change is saved to state without being applied.
 	d.Partial(true)
 
+	if d.HasChange("storage_type") || d.HasChange("iops") || d.HasChange("allocated_storage") {
+		// storage_type is Computed, so an unchanged value comes from state and
+		// is left empty here, letting iops imply io1 as it does on create.
+		storageType := ""
+		if d.HasChange("storage_type") {
+			storageType = d.Get("storage_type").(string)
+		}
+
+		if err := validateDbInstanceStorage(
+			storageType,
+			d.Get("iops").(int),
+			d.Get("allocated_storage").(int)); err != nil {
+			return err
+		}
+	}
+
+	if name := d.Get("option_group_name").(string); checkDbInstanceOptionGroupOnUpdate(
+		name, d.HasChange("option_group_name"), d.HasChange("engine_version")) {
+		if err := validateDbInstanceOptionGroup(
+			conn, d.Get("engine").(string), d.Get("engine_version").(string), name); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("engine_version") {
+		o, n := d.GetChange("engine_version")
+		if err := validateDbInstanceEngineUpgrade(
+			o.(string), n.(string), d.Get("allow_major_version_upgrade").(bool)); err != nil {
+			return err
+		}
+	}
+
 	req := &rds.ModifyDBInstanceInput{
 		ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
 		DBInstanceIdentifier: aws.String(d.Id()),
@@ -701,9 +987,15 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		req.DBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 		requestUpdate = true
 	}
+	if d.HasChange("option_group_name") {
+		d.SetPartial("option_group_name")
+		req.OptionGroupName = aws.String(d.Get("option_group_name").(string))
+		requestUpdate = true
+	}
 	if d.HasChange("engine_version") {
 		d.SetPartial("engine_version")
 		req.EngineVersion = aws.String(d.Get("engine_version").(string))
+		req.AllowMajorVersionUpgrade = aws.Bool(d.Get("allow_major_version_upgrade").(bool))
 		requestUpdate = true
 	}
 	if d.HasChange("iops") {
@@ -794,6 +1086,20 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 			if err != nil {
 				return fmt.Errorf("Error promoting database: %#v", err)
 			}
+
+			log.Println(
+				"[INFO] Waiting for DB Instance to be promoted to a standalone instance")
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"promoting", "modifying", "backing-up", "rebooting"},
+				Target:     "available",
+				Refresh:    resourceAwsDbInstancePromoteRefreshFunc(d, meta),
+				Timeout:    40 * time.Minute,
+				MinTimeout: 10 * time.Second,
+				Delay:      30 * time.Second, // Wait 30 secs before starting
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				return fmt.Errorf("Error waiting for DB Instance %s to be promoted: %s", d.Id(), err)
+			}
 			d.Set("replicate_source_db", "")
 		} else {
 			return fmt.Errorf("cannot elect new source database for replication")
@@ -801,7 +1107,7 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if arn, err := buildRDSARN(d, meta); err == nil {
-		if err := setTagsRDS(conn, d, arn); err != nil {
+		if err := setTagsRDS(conn, d, arn, meta.(*AWSClient).ignoreTags); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
@@ -863,16 +1169,253 @@ func resourceAwsDbInstanceStateRefreshFunc(
 	}
 }
 
+// resourceAwsDbInstancePromoteRefreshFunc reports a DB Instance as
+// "promoting" for as long as it still has a replication source, and by its
+// instance status afterwards.
+func resourceAwsDbInstancePromoteRefreshFunc(
+	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := resourceAwsDbInstanceRetrieve(d, meta)
+		if err != nil {
+			log.Printf("Error on retrieving DB Instance when waiting: %s", err)
+			return nil, "", err
+		}
+
+		if v == nil {
+			return nil, "", nil
+		}
+
+		if v.ReadReplicaSourceDBInstanceIdentifier != nil && *v.ReadReplicaSourceDBInstanceIdentifier != "" {
+			log.Printf("[DEBUG] DB Instance %s still replicating from %s", d.Id(), *v.ReadReplicaSourceDBInstanceIdentifier)
+			return v, "promoting", nil
+		}
+
+		return v, *v.DBInstanceStatus, nil
+	}
+}
+
+// validateDbInstanceCharacterSet returns an error if a character set is
+// requested for an engine other than Oracle, the only engine that accepts
+// one at create time.
+func validateDbInstanceCharacterSet(engine, characterSet string) error {
+	if characterSet == "" || strings.HasPrefix(strings.ToLower(engine), "oracle-") {
+		return nil
+	}
+
+	return fmt.Errorf("character_set_name can only be set for Oracle engines, got engine %q", engine)
+}
+
+// validateDbInstanceEngineUpgrade returns an error if moving from one
+// engine version to the other is a major version upgrade that hasn't been
+// allowed with allow_major_version_upgrade.
+func validateDbInstanceEngineUpgrade(oldVersion, newVersion string, allowMajor bool) error {
+	if oldVersion == "" || newVersion == "" || allowMajor {
+		return nil
+	}
+
+	if rdsMajorEngineVersion(oldVersion) != rdsMajorEngineVersion(newVersion) {
+		return fmt.Errorf(
+			"Upgrading engine_version from %s to %s is a major version upgrade and "+
+				"requires allow_major_version_upgrade to be true", oldVersion, newVersion)
+	}
+
+	return nil
+}
+
+// rdsMajorEngineVersion returns the major version of an RDS engine version,
+// the first two components for all engines, e.g. 5.6 for 5.6.21 and 11.00 for
+// 11.00.2100.60.v1.
+func rdsMajorEngineVersion(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+
+	return parts[0] + "." + parts[1]
+}
+
+// validateDbInstanceRestoreToPointInTime returns an error unless exactly one
+// of restore_time and use_latest_restorable_time is given.
+func validateDbInstanceRestoreToPointInTime(restoreTime string, useLatest bool) error {
+	if restoreTime != "" && useLatest {
+		return fmt.Errorf(
+			"restore_time and use_latest_restorable_time can't both be set in restore_to_point_in_time")
+	}
+	if restoreTime == "" && !useLatest {
+		return fmt.Errorf(
+			"restore_to_point_in_time requires either restore_time or use_latest_restorable_time")
+	}
+
+	return nil
+}
+
+func validateRdsRestoreTime(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be an RFC3339 timestamp, e.g. 2015-09-05T08:15:00Z: %s", k, err))
+	}
+
+	return
+}
+
+// validateDbInstanceMultiAZ returns an error if an availability zone is
+// requested for a Multi-AZ instance, which RDS places across zones itself.
+func validateDbInstanceMultiAZ(multiAZ bool, availabilityZone string) error {
+	if multiAZ && availabilityZone != "" {
+		return fmt.Errorf(
+			"availability_zone (%s) can't be set when multi_az is true; "+
+				"RDS chooses the zones of a Multi-AZ deployment", availabilityZone)
+	}
+
+	return nil
+}
+
+// validateDbInstanceEncryption checks that a KMS key is only given for
+// encrypted storage, and that encryption is supported by the instance class.
+func validateDbInstanceEncryption(encrypted bool, kmsKeyId, instanceClass string) error {
+	if kmsKeyId != "" && !encrypted {
+		return fmt.Errorf("kms_key_id requires storage_encrypted to be true")
+	}
+
+	if encrypted {
+		if instanceClass == "db.t1.micro" || instanceClass == "db.t2.micro" ||
+			strings.HasPrefix(instanceClass, "db.m1.") || strings.HasPrefix(instanceClass, "db.m2.") {
+			return fmt.Errorf(
+				"storage_encrypted is not supported on instance_class %q; "+
+					"use db.t2.small, db.m3.medium or larger for encrypted storage", instanceClass)
+		}
+	}
+
+	return nil
+}
+
+// validateDbInstanceStorage checks that storage_type, iops and
+// allocated_storage are consistent with each other, so a mismatch fails
+// before a long running create or modify is attempted. An empty storage type
+// with iops set implies "io1".
+func validateDbInstanceStorage(storageType string, iops, allocatedStorage int) error {
+	if storageType == "" && iops > 0 {
+		storageType = "io1"
+	}
+
+	switch storageType {
+	case "io1":
+		if iops == 0 {
+			return fmt.Errorf("iops must be set when storage_type is \"io1\"")
+		}
+		if allocatedStorage > 0 {
+			if iops < 3*allocatedStorage || iops > 10*allocatedStorage {
+				return fmt.Errorf(
+					"iops (%d) must be between 3 and 10 times allocated_storage (%d) when storage_type is \"io1\"",
+					iops, allocatedStorage)
+			}
+		}
+	case "standard", "gp2":
+		if iops > 0 {
+			return fmt.Errorf("iops can only be set when storage_type is \"io1\", got %q", storageType)
+		}
+	}
+
+	return nil
+}
+
+// validateDbInstanceOptionGroup looks up the named DB Option Group and
+// verifies it can be attached to an instance of the given engine and engine
+// version. If the group can't be described, e.g. because it is being created
+// in the same apply, the check is skipped and left to the API.
+func validateDbInstanceOptionGroup(conn *rds.RDS, engine, engineVersion, name string) error {
+	resp, err := conn.DescribeOptionGroups(&rds.DescribeOptionGroupsInput{
+		OptionGroupName: aws.String(name),
+	})
+	if err != nil || len(resp.OptionGroupsList) == 0 {
+		log.Printf("[DEBUG] Unable to describe DB Option Group %s, skipping engine check: %s", name, err)
+		return nil
+	}
+
+	return checkDbInstanceOptionGroupEngine(engine, engineVersion, resp.OptionGroupsList[0])
+}
+
+// checkDbInstanceOptionGroupOnUpdate reports whether the option group should
+// be checked against the engine version during an update. option_group_name
+// is Computed, so when only engine_version changes the name may just be the
+// default group RDS assigned, e.g. "default:mysql-5-6". RDS moves the
+// instance to the new version's default group itself, so those are skipped.
+func checkDbInstanceOptionGroupOnUpdate(name string, groupChanged, versionChanged bool) bool {
+	if name == "" {
+		return false
+	}
+	if groupChanged {
+		return true
+	}
+
+	return versionChanged && !strings.HasPrefix(name, "default:")
+}
+
+// checkDbInstanceOptionGroupEngine returns an error if the option group was
+// built for a different engine or major engine version than the instance.
+func checkDbInstanceOptionGroupEngine(engine, engineVersion string, og *rds.OptionGroup) error {
+	if og.EngineName == nil {
+		return nil
+	}
+
+	if strings.HasPrefix(strings.ToLower(engine), "aurora") &&
+		!strings.HasPrefix(strings.ToLower(*og.EngineName), "aurora") {
+		return fmt.Errorf(
+			"DB Option Group %q is for engine %q and can't be used with %q. "+
+				"Aurora instances are configured through DB cluster parameter groups and "+
+				"most options do not apply; use an aws_rds_cluster with "+
+				"aws_rds_cluster_instance resources instead.",
+			*og.OptionGroupName, *og.EngineName, engine)
+	}
+
+	if !strings.EqualFold(engine, *og.EngineName) {
+		return fmt.Errorf(
+			"DB Option Group %q is for engine %q and can't be used with %q",
+			*og.OptionGroupName, *og.EngineName, engine)
+	}
+
+	if engineVersion != "" && og.MajorEngineVersion != nil {
+		major := *og.MajorEngineVersion
+		if engineVersion != major && !strings.HasPrefix(engineVersion, major+".") {
+			return fmt.Errorf(
+				"DB Option Group %q is for %s %s and can't be used with engine_version %q",
+				*og.OptionGroupName, *og.EngineName, major, engineVersion)
+		}
+	}
+
+	return nil
+}
+
 func buildRDSARN(d *schema.ResourceData, meta interface{}) (string, error) {
-	iamconn := meta.(*AWSClient).iamconn
 	region := meta.(*AWSClient).region
+	accountID, err := rdsAccountID(meta)
+	if err != nil {
+		return "", err
+	}
+	arn := fmt.Sprintf("arn:aws:rds:%s:%s:db:%s", region, accountID, d.Id())
+	return arn, nil
+}
+
+func buildRDSSnapshotARN(identifier string, meta interface{}) (string, error) {
+	region := meta.(*AWSClient).region
+	accountID, err := rdsAccountID(meta)
+	if err != nil {
+		return "", err
+	}
+	arn := fmt.Sprintf("arn:aws:rds:%s:%s:snapshot:%s", region, accountID, identifier)
+	return arn, nil
+}
+
+// rdsAccountID returns the account ID of the current IAM user, which RDS
+// ARNs are built from.
+func rdsAccountID(meta interface{}) (string, error) {
+	iamconn := meta.(*AWSClient).iamconn
 	// An zero value GetUserInput{} defers to the currently logged in user
 	resp, err := iamconn.GetUser(&iam.GetUserInput{})
 	if err != nil {
 		return "", err
 	}
 	userARN := *resp.User.Arn
-	accountID := strings.Split(userARN, ":")[4]
-	arn := fmt.Sprintf("arn:aws:rds:%s:%s:db:%s", region, accountID, d.Id())
-	return arn, nil
+	return strings.Split(userARN, ":")[4], nil
 }
@@ -48,6 +48,70 @@ func TestAccAWSDBInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBInstance_publiclyAccessible(t *testing.T) {
+	var v rds.DBInstance
+	ri := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDBInstancePubliclyAccessibleConfig(ri, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					testAccCheckAWSDBInstancePubliclyAccessible(&v, false),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "publicly_accessible", "false"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccAWSDBInstancePubliclyAccessibleConfig(ri, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					testAccCheckAWSDBInstancePubliclyAccessible(&v, true),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "publicly_accessible", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDBInstance_autoMinorVersionUpgrade(t *testing.T) {
+	var v rds.DBInstance
+	ri := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDBInstanceAutoMinorVersionUpgradeConfig(ri, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					testAccCheckAWSDBInstanceAutoMinorVersionUpgrade(&v, false),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "auto_minor_version_upgrade", "false"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccAWSDBInstanceAutoMinorVersionUpgradeConfig(ri, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &v),
+					testAccCheckAWSDBInstanceAutoMinorVersionUpgrade(&v, true),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.bar", "auto_minor_version_upgrade", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBInstanceReplica(t *testing.T) {
 	var s, r rds.DBInstance
 
@@ -68,6 +132,64 @@ func TestAccAWSDBInstanceReplica(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBInstance_restoreToPointInTime(t *testing.T) {
+	var s, r rds.DBInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRestoreToPointInTimeInstanceConfig(rand.New(rand.NewSource(time.Now().UnixNano())).Int()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &s),
+					testAccCheckAWSDBInstanceExists("aws_db_instance.restored", &r),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.restored", "engine", "mysql"),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.restored", "restore_to_point_in_time.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.restored", "restore_to_point_in_time.0.use_latest_restorable_time", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDBInstanceReplica_promote(t *testing.T) {
+	var s, r rds.DBInstance
+	ri := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccReplicaInstanceConfig(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.bar", &s),
+					testAccCheckAWSDBInstanceExists("aws_db_instance.replica", &r),
+					testAccCheckAWSDBInstanceReplicaAttributes(&s, &r),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccReplicaPromotedInstanceConfig(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.replica", &r),
+					testAccCheckAWSDBInstancePromoted(&r),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.replica", "replicate_source_db", ""),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.replica", "status", "available"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBInstanceSnapshot(t *testing.T) {
 	var snap rds.DBInstance
 
@@ -86,6 +208,26 @@ func TestAccAWSDBInstanceSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBInstanceSnapshot_tags(t *testing.T) {
+	var snap rds.DBInstance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceSnapshotTags,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSnapshotTagsInstanceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists("aws_db_instance.snapshot", &snap),
+					resource.TestCheckResourceAttr(
+						"aws_db_instance.snapshot", "final_snapshot_tags.Retain", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBInstanceNoSnapshot(t *testing.T) {
 	var nosnap rds.DBInstance
 
@@ -104,6 +246,535 @@ func TestAccAWSDBInstanceNoSnapshot(t *testing.T) {
 	})
 }
 
+func TestResourceAWSDBInstanceOptionGroupEngine_validation(t *testing.T) {
+	cases := []struct {
+		Engine        string
+		EngineVersion string
+		GroupEngine   string
+		GroupVersion  string
+		ExpectError   bool
+	}{
+		{
+			Engine:       "aurora",
+			GroupEngine:  "mysql",
+			GroupVersion: "5.6",
+			ExpectError:  true,
+		},
+		{
+			Engine:       "aurora",
+			GroupEngine:  "aurora",
+			GroupVersion: "5.6",
+			ExpectError:  false,
+		},
+		{
+			Engine:        "mysql",
+			EngineVersion: "5.6.21",
+			GroupEngine:   "mysql",
+			GroupVersion:  "5.6",
+			ExpectError:   false,
+		},
+		{
+			Engine:       "mysql",
+			GroupEngine:  "mysql",
+			GroupVersion: "5.6",
+			ExpectError:  false,
+		},
+		{
+			Engine:        "mysql",
+			EngineVersion: "5.5.46",
+			GroupEngine:   "mysql",
+			GroupVersion:  "5.6",
+			ExpectError:   true,
+		},
+		{
+			Engine:        "sqlserver-se",
+			EngineVersion: "11.00.2100.60.v1",
+			GroupEngine:   "sqlserver-se",
+			GroupVersion:  "11.00",
+			ExpectError:   false,
+		},
+		{
+			Engine:       "mysql",
+			GroupEngine:  "oracle-ee",
+			GroupVersion: "11.2",
+			ExpectError:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		og := &rds.OptionGroup{
+			OptionGroupName:    aws.String("tf-test-option-group"),
+			EngineName:         aws.String(tc.GroupEngine),
+			MajorEngineVersion: aws.String(tc.GroupVersion),
+		}
+		err := checkDbInstanceOptionGroupEngine(tc.Engine, tc.EngineVersion, og)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %s %q with option group for %s %s",
+				tc.Engine, tc.EngineVersion, tc.GroupEngine, tc.GroupVersion)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Unexpected error for %s %q with option group for %s %s: %s",
+				tc.Engine, tc.EngineVersion, tc.GroupEngine, tc.GroupVersion, err)
+		}
+	}
+}
+
+func TestResourceAWSDBInstanceOptionGroupOnUpdate(t *testing.T) {
+	cases := []struct {
+		Name           string
+		GroupChanged   bool
+		VersionChanged bool
+		Expected       bool
+	}{
+		// engine_version changed, option group came from state
+		{
+			Name:           "default:mysql-5-6",
+			VersionChanged: true,
+			Expected:       false,
+		},
+		{
+			Name:           "tf-test-option-group",
+			VersionChanged: true,
+			Expected:       true,
+		},
+		{
+			Name:         "default:mysql-5-7",
+			GroupChanged: true,
+			Expected:     true,
+		},
+		{
+			Name:           "",
+			GroupChanged:   true,
+			VersionChanged: true,
+			Expected:       false,
+		},
+		{
+			Name:     "tf-test-option-group",
+			Expected: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if v := checkDbInstanceOptionGroupOnUpdate(tc.Name, tc.GroupChanged, tc.VersionChanged); v != tc.Expected {
+			t.Fatalf("%d: expected %t for %#v, got %t", i, tc.Expected, tc, v)
+		}
+	}
+}
+
+func TestResourceAWSDBInstanceStorage_validation(t *testing.T) {
+	cases := []struct {
+		StorageType      string
+		Iops             int
+		AllocatedStorage int
+		ExpectError      bool
+	}{
+		{
+			StorageType:      "io1",
+			Iops:             1000,
+			AllocatedStorage: 100,
+			ExpectError:      false,
+		},
+		{
+			StorageType:      "",
+			Iops:             1000,
+			AllocatedStorage: 100,
+			ExpectError:      false,
+		},
+		{
+			StorageType:      "io1",
+			Iops:             0,
+			AllocatedStorage: 100,
+			ExpectError:      true,
+		},
+		{
+			StorageType:      "io1",
+			Iops:             1000,
+			AllocatedStorage: 10,
+			ExpectError:      true,
+		},
+		{
+			StorageType:      "io1",
+			Iops:             1000,
+			AllocatedStorage: 500,
+			ExpectError:      true,
+		},
+		{
+			StorageType:      "gp2",
+			Iops:             1000,
+			AllocatedStorage: 100,
+			ExpectError:      true,
+		},
+		{
+			StorageType:      "standard",
+			Iops:             0,
+			AllocatedStorage: 100,
+			ExpectError:      false,
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateDbInstanceStorage(tc.StorageType, tc.Iops, tc.AllocatedStorage)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("%d: expected an error for %#v", i, tc)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("%d: unexpected error for %#v: %s", i, tc, err)
+		}
+	}
+}
+
+func TestResourceAWSDBInstanceEngine_validation(t *testing.T) {
+	cases := []struct {
+		Value     string
+		WarnCount int
+	}{
+		{
+			Value:     "mysql",
+			WarnCount: 0,
+		},
+		{
+			Value:     "oracle-se2",
+			WarnCount: 0,
+		},
+		{
+			Value:     "SQLSERVER-EE",
+			WarnCount: 0,
+		},
+		{
+			Value:     "mariadb",
+			WarnCount: 0,
+		},
+		{
+			Value:     "custom-oracle-ee",
+			WarnCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		warnings, errors := validateRdsEngine(tc.Value, "engine")
+		if len(errors) != 0 {
+			t.Fatalf("Expected engine %q to never trigger a validation error, got %v", tc.Value, errors)
+		}
+		if len(warnings) != tc.WarnCount {
+			t.Fatalf("Expected %d warnings for engine %q, got %d", tc.WarnCount, tc.Value, len(warnings))
+		}
+	}
+}
+
+func TestResourceAWSDBInstanceCharacterSet_validation(t *testing.T) {
+	cases := []struct {
+		Engine       string
+		CharacterSet string
+		ExpectError  bool
+	}{
+		{
+			Engine:       "oracle-se1",
+			CharacterSet: "AL32UTF8",
+			ExpectError:  false,
+		},
+		{
+			Engine:       "mysql",
+			CharacterSet: "AL32UTF8",
+			ExpectError:  true,
+		},
+		{
+			Engine:       "mysql",
+			CharacterSet: "",
+			ExpectError:  false,
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateDbInstanceCharacterSet(tc.Engine, tc.CharacterSet)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("%d: expected an error for %#v", i, tc)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("%d: unexpected error for %#v: %s", i, tc, err)
+		}
+	}
+}
+
+func TestResourceAWSDBInstanceLicenseModel_validation(t *testing.T) {
+	cases := []struct {
+		Engine       string
+		LicenseModel string
+		ExpectError  bool
+	}{
+		{
+			Engine:       "mysql",
+			LicenseModel: "general-public-license",
+			ExpectError:  false,
+		},
+		{
+			Engine:       "mysql",
+			LicenseModel: "license-included",
+			ExpectError:  true,
+		},
+		{
+			Engine:       "oracle-se1",
+			LicenseModel: "license-included",
+			ExpectError:  false,
+		},
+		{
+			Engine:       "oracle-ee",
+			LicenseModel: "license-included",
+			ExpectError:  true,
+		},
+		{
+			Engine:       "sqlserver-ee",
+			LicenseModel: "bring-your-own-license",
+			ExpectError:  false,
+		},
+		{
+			Engine:       "sqlserver-ex",
+			LicenseModel: "bring-your-own-license",
+			ExpectError:  true,
+		},
+		{
+			Engine:       "custom-oracle-ee",
+			LicenseModel: "bring-your-own-license",
+			ExpectError:  false,
+		},
+	}
+
+	for i, tc := range cases {
+		_, errors := validateRdsLicenseModel(tc.LicenseModel, "license_model")
+		if len(errors) != 0 {
+			t.Fatalf("%d: expected %q to be a valid license model", i, tc.LicenseModel)
+		}
+
+		err := checkRdsLicenseModel(tc.Engine, tc.LicenseModel)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("%d: expected an error for %#v", i, tc)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("%d: unexpected error for %#v: %s", i, tc, err)
+		}
+	}
+
+	if _, errors := validateRdsLicenseModel("free", "license_model"); len(errors) != 1 {
+		t.Fatalf("Expected an unknown license model to trigger a validation error")
+	}
+}
+
+func TestResourceAWSDBInstanceEncryption_validation(t *testing.T) {
+	cases := []struct {
+		Encrypted     bool
+		KmsKeyId      string
+		InstanceClass string
+		ExpectError   bool
+	}{
+		{
+			Encrypted:     true,
+			KmsKeyId:      "arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+			InstanceClass: "db.m3.medium",
+			ExpectError:   false,
+		},
+		{
+			Encrypted:     true,
+			InstanceClass: "db.m3.medium",
+			ExpectError:   false,
+		},
+		{
+			Encrypted:     false,
+			KmsKeyId:      "arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+			InstanceClass: "db.m3.medium",
+			ExpectError:   true,
+		},
+		{
+			Encrypted:     true,
+			InstanceClass: "db.t2.micro",
+			ExpectError:   true,
+		},
+		{
+			Encrypted:     false,
+			InstanceClass: "db.t1.micro",
+			ExpectError:   false,
+		},
+		{
+			Encrypted:     true,
+			InstanceClass: "db.t2.small",
+			ExpectError:   false,
+		},
+		{
+			Encrypted:     true,
+			InstanceClass: "db.m1.medium",
+			ExpectError:   true,
+		},
+		{
+			Encrypted:     true,
+			InstanceClass: "db.m1.xlarge",
+			ExpectError:   true,
+		},
+		{
+			Encrypted:     true,
+			InstanceClass: "db.m2.2xlarge",
+			ExpectError:   true,
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateDbInstanceEncryption(tc.Encrypted, tc.KmsKeyId, tc.InstanceClass)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("%d: expected an error for %#v", i, tc)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("%d: unexpected error for %#v: %s", i, tc, err)
+		}
+	}
+}
+
+func TestResourceAWSDBInstanceMultiAZ_validation(t *testing.T) {
+	cases := []struct {
+		MultiAZ          bool
+		AvailabilityZone string
+		ExpectError      bool
+	}{
+		{
+			MultiAZ:          true,
+			AvailabilityZone: "us-west-2a",
+			ExpectError:      true,
+		},
+		{
+			MultiAZ:     true,
+			ExpectError: false,
+		},
+		{
+			MultiAZ:          false,
+			AvailabilityZone: "us-west-2a",
+			ExpectError:      false,
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateDbInstanceMultiAZ(tc.MultiAZ, tc.AvailabilityZone)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("%d: expected an error for %#v", i, tc)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("%d: unexpected error for %#v: %s", i, tc, err)
+		}
+	}
+}
+
+func TestResourceAWSDBInstanceRestoreToPointInTime_validation(t *testing.T) {
+	cases := []struct {
+		RestoreTime string
+		UseLatest   bool
+		ExpectError bool
+	}{
+		{
+			RestoreTime: "2015-09-05T08:15:00Z",
+			ExpectError: false,
+		},
+		{
+			UseLatest:   true,
+			ExpectError: false,
+		},
+		{
+			RestoreTime: "2015-09-05T08:15:00Z",
+			UseLatest:   true,
+			ExpectError: true,
+		},
+		{
+			ExpectError: true,
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateDbInstanceRestoreToPointInTime(tc.RestoreTime, tc.UseLatest)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("%d: expected an error for %#v", i, tc)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("%d: unexpected error for %#v: %s", i, tc, err)
+		}
+	}
+}
+
+func TestResourceAWSDBInstanceRestoreTime_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "2015-09-05T08:15:00Z",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2015-09-05T08:15:00+02:00",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2015-09-05 08:15:00",
+			ErrCount: 1,
+		},
+		{
+			Value:    "yesterday",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateRdsRestoreTime(tc.Value, "restore_time")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestResourceAWSDBInstanceEngineUpgrade_validation(t *testing.T) {
+	cases := []struct {
+		Old, New    string
+		AllowMajor  bool
+		ExpectError bool
+	}{
+		{
+			Old:         "5.6.21",
+			New:         "5.6.23",
+			ExpectError: false,
+		},
+		{
+			Old:         "5.6.23",
+			New:         "5.7.10",
+			ExpectError: true,
+		},
+		{
+			Old:         "5.6.23",
+			New:         "5.7.10",
+			AllowMajor:  true,
+			ExpectError: false,
+		},
+		{
+			Old:         "9.3.10",
+			New:         "9.4.5",
+			ExpectError: true,
+		},
+		{
+			Old:         "11.00.2100.60.v1",
+			New:         "11.00.5058.0.v1",
+			ExpectError: false,
+		},
+		{
+			Old:         "",
+			New:         "5.7.10",
+			ExpectError: false,
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateDbInstanceEngineUpgrade(tc.Old, tc.New, tc.AllowMajor)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("%d: expected an error for %#v", i, tc)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("%d: unexpected error for %#v: %s", i, tc, err)
+		}
+	}
+}
+
 func testAccCheckAWSDBInstanceDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).rdsconn
 
@@ -169,6 +840,36 @@ func testAccCheckAWSDBInstanceReplicaAttributes(source, replica *rds.DBInstance)
 	}
 }
 
+func testAccCheckAWSDBInstancePubliclyAccessible(v *rds.DBInstance, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.PubliclyAccessible == nil || *v.PubliclyAccessible != expected {
+			return fmt.Errorf("bad publicly_accessible, expected %t: %#v", expected, v.PubliclyAccessible)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDBInstanceAutoMinorVersionUpgrade(v *rds.DBInstance, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.AutoMinorVersionUpgrade == nil || *v.AutoMinorVersionUpgrade != expected {
+			return fmt.Errorf("bad auto_minor_version_upgrade, expected %t: %#v", expected, v.AutoMinorVersionUpgrade)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDBInstancePromoted(v *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.ReadReplicaSourceDBInstanceIdentifier != nil && *v.ReadReplicaSourceDBInstanceIdentifier != "" {
+			return fmt.Errorf("DB Instance still replicating from %s", *v.ReadReplicaSourceDBInstanceIdentifier)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSDBInstanceSnapshot(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).rdsconn
 
@@ -223,6 +924,41 @@ func testAccCheckAWSDBInstanceSnapshot(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckAWSDBInstanceSnapshotTags(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_instance" {
+			continue
+		}
+
+		snapshot_identifier := "foobarbaz-test-terraform-final-snapshot-3"
+		arn, err := buildRDSSnapshotARN(snapshot_identifier, testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.ListTagsForResource(&rds.ListTagsForResourceInput{
+			ResourceName: aws.String(arn),
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing tags for final snapshot %s: %s", snapshot_identifier, err)
+		}
+		if err := testAccCheckRDSTags(resp.TagList, "Retain", "true")(s); err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Deleting the Snapshot %s", snapshot_identifier)
+		if _, err := conn.DeleteDBSnapshot(&rds.DeleteDBSnapshotInput{
+			DBSnapshotIdentifier: aws.String(snapshot_identifier),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckAWSDBInstanceNoSnapshot(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).rdsconn
 
@@ -327,6 +1063,50 @@ resource "aws_db_instance" "bar" {
 	parameter_group_name = "default.mysql5.6"
 }`, rand.New(rand.NewSource(time.Now().UnixNano())).Int())
 
+func testAccAWSDBInstancePubliclyAccessibleConfig(val int, public bool) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "bar" {
+	identifier = "foobarbaz-test-terraform-%d"
+
+	allocated_storage = 10
+	engine = "mysql"
+	engine_version = "5.6.21"
+	instance_class = "db.t1.micro"
+	name = "baz"
+	password = "barbarbarbar"
+	username = "foo"
+
+	backup_retention_period = 0
+	apply_immediately = true
+
+	publicly_accessible = %t
+
+	parameter_group_name = "default.mysql5.6"
+}`, val, public)
+}
+
+func testAccAWSDBInstanceAutoMinorVersionUpgradeConfig(val int, upgrade bool) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "bar" {
+	identifier = "foobarbaz-test-terraform-%d"
+
+	allocated_storage = 10
+	engine = "mysql"
+	engine_version = "5.6.21"
+	instance_class = "db.t1.micro"
+	name = "baz"
+	password = "barbarbarbar"
+	username = "foo"
+
+	backup_retention_period = 0
+	apply_immediately = true
+
+	auto_minor_version_upgrade = %t
+
+	parameter_group_name = "default.mysql5.6"
+}`, val, upgrade)
+}
+
 func testAccReplicaInstanceConfig(val int) string {
 	return fmt.Sprintf(`
 	resource "aws_db_instance" "bar" {
@@ -362,6 +1142,76 @@ func testAccReplicaInstanceConfig(val int) string {
 	`, val, val)
 }
 
+func testAccRestoreToPointInTimeInstanceConfig(val int) string {
+	return fmt.Sprintf(`
+	resource "aws_db_instance" "bar" {
+		identifier = "foobarbaz-test-terraform-%d"
+
+		allocated_storage = 5
+		engine = "mysql"
+		engine_version = "5.6.21"
+		instance_class = "db.t1.micro"
+		name = "baz"
+		password = "barbarbarbar"
+		username = "foo"
+
+		backup_retention_period = 1
+
+		parameter_group_name = "default.mysql5.6"
+	}
+
+	resource "aws_db_instance" "restored" {
+		identifier = "tf-restored-db-%d"
+		allocated_storage = "${aws_db_instance.bar.allocated_storage}"
+		engine = "mysql"
+		instance_class = "db.t1.micro"
+		password = "${aws_db_instance.bar.password}"
+		username = "${aws_db_instance.bar.username}"
+		backup_retention_period = 0
+		apply_immediately = true
+
+		restore_to_point_in_time {
+			source_db_instance_identifier = "${aws_db_instance.bar.identifier}"
+			use_latest_restorable_time = true
+		}
+	}
+	`, val, val)
+}
+
+func testAccReplicaPromotedInstanceConfig(val int) string {
+	return fmt.Sprintf(`
+	resource "aws_db_instance" "bar" {
+		identifier = "foobarbaz-test-terraform-%d"
+
+		allocated_storage = 5
+		engine = "mysql"
+		engine_version = "5.6.21"
+		instance_class = "db.t1.micro"
+		name = "baz"
+		password = "barbarbarbar"
+		username = "foo"
+
+		backup_retention_period = 1
+
+		parameter_group_name = "default.mysql5.6"
+	}
+
+	resource "aws_db_instance" "replica" {
+	  identifier = "tf-replica-db-%d"
+		backup_retention_period = 0
+		allocated_storage = "${aws_db_instance.bar.allocated_storage}"
+		engine = "${aws_db_instance.bar.engine}"
+		engine_version = "${aws_db_instance.bar.engine_version}"
+		instance_class = "${aws_db_instance.bar.instance_class}"
+		password = "${aws_db_instance.bar.password}"
+		username = "${aws_db_instance.bar.username}"
+		tags {
+			Name = "tf-replica-db"
+		}
+	}
+	`, val, val)
+}
+
 var testAccSnapshotInstanceConfig = `
 provider "aws" {
   region = "us-east-1"
@@ -386,6 +1236,33 @@ resource "aws_db_instance" "snapshot" {
 }
 `
 
+var testAccSnapshotTagsInstanceConfig = `
+provider "aws" {
+  region = "us-east-1"
+}
+resource "aws_db_instance" "snapshot" {
+	identifier = "foobarbaz-test-terraform-snapshot-3"
+
+	allocated_storage = 5
+	engine = "mysql"
+	engine_version = "5.6.21"
+	instance_class = "db.t1.micro"
+	name = "baz"
+	password = "barbarbarbar"
+	username = "foo"
+	security_group_names = ["default"]
+	backup_retention_period = 1
+
+	parameter_group_name = "default.mysql5.6"
+
+	skip_final_snapshot = false
+	final_snapshot_identifier = "foobarbaz-test-terraform-final-snapshot-3"
+	final_snapshot_tags {
+		Retain = "true"
+	}
+}
+`
+
 var testAccNoSnapshotInstanceConfig = `
 provider "aws" {
   region = "us-east-1"
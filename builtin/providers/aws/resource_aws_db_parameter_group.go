@@ -84,7 +84,7 @@ func resourceAwsDbParameterGroup() *schema.Resource {
 
 func resourceAwsDbParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}), meta.(*AWSClient).ignoreTags)
 
 	createOpts := rds.CreateDBParameterGroupInput{
 		DBParameterGroupName:   aws.String(d.Get("name").(string)),
@@ -167,7 +167,7 @@ func resourceAwsDbParameterGroupRead(d *schema.ResourceData, meta interface{}) e
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		d.Set("tags", tagsToMapRDS(dt, meta.(*AWSClient).ignoreTags))
 	}
 
 	return nil
@@ -212,7 +212,7 @@ func resourceAwsDbParameterGroupUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if arn, err := buildRDSPGARN(d, meta); err == nil {
-		if err := setTagsRDS(rdsconn, d, arn); err != nil {
+		if err := setTagsRDS(rdsconn, d, arn, meta.(*AWSClient).ignoreTags); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
@@ -87,6 +87,28 @@ func TestAccAWSDBParameterGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBParameterGroup_computedTags(t *testing.T) {
+	var v rds.DBParameterGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSDBParameterGroupComputedTagsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBParameterGroupExists("aws_db_parameter_group.bar", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_parameter_group.bar", "tags.#", "2"),
+					testAccCheckAWSDBParameterGroupTagFromAttr(
+						"aws_db_parameter_group.bar", "Source", "aws_db_parameter_group.source", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBParameterGroupOnly(t *testing.T) {
 	var v rds.DBParameterGroup
 
@@ -152,6 +174,31 @@ func TestResourceAWSDBParameterGroupName_validation(t *testing.T) {
 	}
 }
 
+// testAccCheckAWSDBParameterGroupTagFromAttr checks that the tag on one
+// resource holds the value of an attribute computed by another.
+func testAccCheckAWSDBParameterGroupTagFromAttr(n, tag, source, attr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		src, ok := s.RootModule().Resources[source]
+		if !ok {
+			return fmt.Errorf("Not found: %s", source)
+		}
+
+		expected := src.Primary.Attributes[attr]
+		if expected == "" {
+			return fmt.Errorf("%s has no %s", source, attr)
+		}
+		if v := rs.Primary.Attributes["tags."+tag]; v != expected {
+			return fmt.Errorf("bad tag %s: expected %q, got %q", tag, expected, v)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSDBParameterGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).rdsconn
 
@@ -304,6 +351,24 @@ resource "aws_db_parameter_group" "bar" {
 }
 `
 
+const testAccAWSDBParameterGroupComputedTagsConfig = `
+resource "aws_db_parameter_group" "source" {
+	name = "parameter-group-test-terraform-source"
+	family = "mysql5.6"
+	description = "Test parameter group for terraform"
+}
+
+resource "aws_db_parameter_group" "bar" {
+	name = "parameter-group-test-terraform"
+	family = "mysql5.6"
+	description = "Test parameter group for terraform"
+	tags {
+		foo = "bar"
+		Source = "${aws_db_parameter_group.source.arn}"
+	}
+}
+`
+
 const testAccAWSDBParameterGroupOnlyConfig = `
 resource "aws_db_parameter_group" "bar" {
 	name = "parameter-group-test-terraform"
@@ -82,7 +82,7 @@ func resourceAwsDbSecurityGroup() *schema.Resource {
 
 func resourceAwsDbSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}), meta.(*AWSClient).ignoreTags)
 
 	var err error
 	var errs []error
@@ -191,7 +191,7 @@ func resourceAwsDbSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		d.Set("tags", tagsToMapRDS(dt, meta.(*AWSClient).ignoreTags))
 	}
 
 	return nil
@@ -202,7 +202,7 @@ func resourceAwsDbSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 
 	d.Partial(true)
 	if arn, err := buildRDSSecurityGroupARN(d, meta); err == nil {
-		if err := setTagsRDS(conn, d, arn); err != nil {
+		if err := setTagsRDS(conn, d, arn, meta.(*AWSClient).ignoreTags); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
@@ -74,7 +74,7 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 
 func resourceAwsRDSClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}), meta.(*AWSClient).ignoreTags)
 
 	createOpts := &rds.CreateDBInstanceInput{
 		DBInstanceClass:     aws.String(d.Get("instance_class").(string)),
@@ -169,7 +169,7 @@ func resourceAwsRDSClusterInstanceRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		log.Printf("[DEBUG] Error building ARN for RDS Cluster Instance (%s), not setting Tags", *db.DBInstanceIdentifier)
 	} else {
-		if err := saveTagsRDS(conn, d, arn); err != nil {
+		if err := saveTagsRDS(conn, d, arn, meta.(*AWSClient).ignoreTags); err != nil {
 			log.Printf("[WARN] Failed to save tags for RDS Cluster Instance (%s): %s", *db.DBClusterIdentifier, err)
 		}
 	}
@@ -181,7 +181,7 @@ func resourceAwsRDSClusterInstanceUpdate(d *schema.ResourceData, meta interface{
 	conn := meta.(*AWSClient).rdsconn
 
 	if arn, err := buildRDSARN(d, meta); err == nil {
-		if err := setTagsRDS(conn, d, arn); err != nil {
+		if err := setTagsRDS(conn, d, arn, meta.(*AWSClient).ignoreTags); err != nil {
 			return err
 		}
 	}
@@ -537,6 +537,73 @@ func validateRdsId(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// rdsEngines maps the RDS engine names known to Terraform to the license
+// models each of them accepts. Engines not listed here, such as newly
+// released or RDS Custom engines, are passed through to the API with a
+// warning rather than rejected.
+var rdsEngines = map[string][]string{
+	"aurora":        []string{"general-public-license"},
+	"mariadb":       []string{"general-public-license"},
+	"mysql":         []string{"general-public-license"},
+	"oracle-ee":     []string{"bring-your-own-license"},
+	"oracle-se":     []string{"bring-your-own-license", "license-included"},
+	"oracle-se1":    []string{"bring-your-own-license", "license-included"},
+	"oracle-se2":    []string{"bring-your-own-license", "license-included"},
+	"postgres":      []string{"postgresql-license"},
+	"sqlserver-ee":  []string{"bring-your-own-license", "license-included"},
+	"sqlserver-ex":  []string{"license-included"},
+	"sqlserver-se":  []string{"bring-your-own-license", "license-included"},
+	"sqlserver-web": []string{"license-included"},
+}
+
+func validateRdsEngine(v interface{}, k string) (ws []string, errors []error) {
+	value := strings.ToLower(v.(string))
+	if _, ok := rdsEngines[value]; ok {
+		return
+	}
+
+	names := make([]string, 0, len(rdsEngines))
+	for e := range rdsEngines {
+		names = append(names, e)
+	}
+	sort.Strings(names)
+
+	ws = append(ws, fmt.Sprintf(
+		"%q is not a known RDS engine (%s), it will be passed to the API as is",
+		value, strings.Join(names, ", ")))
+	return
+}
+
+func validateRdsLicenseModel(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case "license-included", "bring-your-own-license", "general-public-license", "postgresql-license":
+	default:
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of license-included, bring-your-own-license, "+
+				"general-public-license or postgresql-license, got %q", k, value))
+	}
+	return
+}
+
+// checkRdsLicenseModel returns an error if the license model isn't
+// available for the engine. Unknown engines are left to the API.
+func checkRdsLicenseModel(engine, licenseModel string) error {
+	models, ok := rdsEngines[strings.ToLower(engine)]
+	if !ok || licenseModel == "" {
+		return nil
+	}
+
+	for _, m := range models {
+		if m == licenseModel {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("license_model %q is not supported by engine %q, expected one of: %s",
+		licenseModel, engine, strings.Join(models, ", "))
+}
+
 func expandESClusterConfig(m map[string]interface{}) *elasticsearch.ElasticsearchClusterConfig {
 	config := elasticsearch.ElasticsearchClusterConfig{}
 
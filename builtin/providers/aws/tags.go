@@ -2,6 +2,7 @@ package aws
 
 import (
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -17,6 +18,47 @@ func tagsSchema() *schema.Schema {
 	}
 }
 
+// tagIgnoreConfig holds the tag keys and key prefixes from the provider's
+// ignore_tags block. Matching tags are managed outside of Terraform, so they
+// are neither read into state nor added or removed. A nil *tagIgnoreConfig
+// ignores nothing.
+type tagIgnoreConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// ignored returns true if the tag key should be left alone.
+func (c *tagIgnoreConfig) ignored(k string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, key := range c.Keys {
+		if k == key {
+			return true
+		}
+	}
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filterMap returns a copy of the tags map without the ignored keys.
+func (c *tagIgnoreConfig) filterMap(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if !c.ignored(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
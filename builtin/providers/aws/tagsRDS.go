@@ -11,38 +11,53 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
+func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string, ignore *tagIgnoreConfig) error {
 	if d.HasChange("tags") {
 		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsRDS(tagsFromMapRDS(o), tagsFromMapRDS(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
-
-			_, err := conn.RemoveTagsFromResource(&rds.RemoveTagsFromResourceInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      k,
-			})
-			if err != nil {
-				return err
-			}
+		o := ignore.filterMap(oraw.(map[string]interface{}))
+		n := ignore.filterMap(nraw.(map[string]interface{}))
+		if err := updateTagsRDS(conn, arn, o, n); err != nil {
+			return err
 		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.AddTagsToResource(&rds.AddTagsToResourceInput{
-				ResourceName: aws.String(arn),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
+	}
+
+	return nil
+}
+
+// updateTagsRDS applies the difference between the old and new tag maps to
+// the resource identified by arn. No API calls are made if the maps are
+// equivalent.
+func updateTagsRDS(conn *rds.RDS, arn string, o, n map[string]interface{}) error {
+	create, remove := diffTagsRDS(tagsFromMapRDS(o, nil), tagsFromMapRDS(n, nil))
+	if len(create) == 0 && len(remove) == 0 {
+		log.Printf("[DEBUG] Tags unchanged for %s", arn)
+		return nil
+	}
+
+	// Set tags
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing tags: %s", remove)
+		k := make([]*string, len(remove), len(remove))
+		for i, t := range remove {
+			k[i] = t.Key
+		}
+
+		_, err := conn.RemoveTagsFromResource(&rds.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(arn),
+			TagKeys:      k,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags: %s", create)
+		_, err := conn.AddTagsToResource(&rds.AddTagsToResourceInput{
+			ResourceName: aws.String(arn),
+			Tags:         create,
+		})
+		if err != nil {
+			return err
 		}
 	}
 
@@ -51,31 +66,44 @@ func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
-// be destroyed.
+// be destroyed. Tags that are unchanged are left out of both sets.
 func diffTagsRDS(oldTags, newTags []*rds.Tag) ([]*rds.Tag, []*rds.Tag) {
-	// First, we're creating everything we have
+	existing := make(map[string]string)
+	for _, t := range oldTags {
+		existing[*t.Key] = *t.Value
+	}
+
+	// Create everything that is new or has changed
 	create := make(map[string]interface{})
+	all := make(map[string]string)
 	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+		all[*t.Key] = *t.Value
+		if old, ok := existing[*t.Key]; !ok || old != *t.Value {
+			create[*t.Key] = *t.Value
+		}
 	}
 
 	// Build the list of what to remove
 	var remove []*rds.Tag
 	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
+		v, ok := all[*t.Key]
+		if !ok || v != *t.Value {
 			// Delete it!
 			remove = append(remove, t)
 		}
 	}
 
-	return tagsFromMapRDS(create), remove
+	return tagsFromMapRDS(create, nil), remove
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
+// tagsFromMap returns the tags for the given map of data, leaving out any
+// tags matched by the provider's ignore_tags configuration.
+func tagsFromMapRDS(m map[string]interface{}, ignore *tagIgnoreConfig) []*rds.Tag {
 	result := make([]*rds.Tag, 0, len(m))
 	for k, v := range m {
+		if ignore.ignored(k) {
+			continue
+		}
 		result = append(result, &rds.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
@@ -85,17 +113,21 @@ func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
 	return result
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapRDS(ts []*rds.Tag) map[string]string {
+// tagsToMap turns the list of tags into a map, leaving out any tags
+// matched by the provider's ignore_tags configuration.
+func tagsToMapRDS(ts []*rds.Tag, ignore *tagIgnoreConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
+		if ignore.ignored(*t.Key) {
+			continue
+		}
 		result[*t.Key] = *t.Value
 	}
 
 	return result
 }
 
-func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
+func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string, ignore *tagIgnoreConfig) error {
 	resp, err := conn.ListTagsForResource(&rds.ListTagsForResourceInput{
 		ResourceName: aws.String(arn),
 	})
@@ -109,5 +141,5 @@ func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
 		dt = resp.TagList
 	}
 
-	return d.Set("tags", tagsToMapRDS(dt))
+	return d.Set("tags", tagsToMapRDS(dt, ignore))
 }
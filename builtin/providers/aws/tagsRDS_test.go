@@ -5,6 +5,9 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -46,12 +49,24 @@ func TestDiffRDSTags(t *testing.T) {
 				"foo": "bar",
 			},
 		},
+
+		// Unchanged
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+			},
+			New: map[string]interface{}{
+				"foo": "bar",
+			},
+			Create: map[string]string{},
+			Remove: map[string]string{},
+		},
 	}
 
 	for i, tc := range cases {
-		c, r := diffTagsRDS(tagsFromMapRDS(tc.Old), tagsFromMapRDS(tc.New))
-		cm := tagsToMapRDS(c)
-		rm := tagsToMapRDS(r)
+		c, r := diffTagsRDS(tagsFromMapRDS(tc.Old, nil), tagsFromMapRDS(tc.New, nil))
+		cm := tagsToMapRDS(c, nil)
+		rm := tagsToMapRDS(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -61,11 +76,110 @@ func TestDiffRDSTags(t *testing.T) {
 	}
 }
 
+func TestUpdateTagsRDS_unchanged(t *testing.T) {
+	conn := rds.New(session.New(&aws.Config{Region: aws.String("us-west-2")}))
+
+	var calls int
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		calls++
+	})
+
+	tags := map[string]interface{}{
+		"foo": "bar",
+		"baz": "qux",
+	}
+	arn := "arn:aws:rds:us-west-2:123456789012:db:tf-test"
+	if err := updateTagsRDS(conn, arn, tags, tags); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if calls != 0 {
+		t.Fatalf("expected no API calls for unchanged tags, got %d", calls)
+	}
+}
+
+func TestTagsToMapRDS_ignore(t *testing.T) {
+	ignore := &tagIgnoreConfig{
+		Keys:        []string{"CostCenter"},
+		KeyPrefixes: []string{"aws:backup:"},
+	}
+
+	tags := tagsFromMapRDS(map[string]interface{}{
+		"Name":                  "tf-test",
+		"CostCenter":            "1234",
+		"CostCenterOwner":       "ops",
+		"aws:backup:source-arn": "arn:aws:backup:us-west-2:123456789012:recovery-point:1",
+	}, nil)
+
+	expected := map[string]string{
+		"Name":            "tf-test",
+		"CostCenterOwner": "ops",
+	}
+	if m := tagsToMapRDS(tags, ignore); !reflect.DeepEqual(m, expected) {
+		t.Fatalf("bad tags: %#v", m)
+	}
+}
+
+func TestTagsFromMapRDS_ignore(t *testing.T) {
+	ignore := &tagIgnoreConfig{
+		Keys:        []string{"CostCenter"},
+		KeyPrefixes: []string{"aws:backup:"},
+	}
+
+	tags := tagsFromMapRDS(map[string]interface{}{
+		"Name":                  "tf-test",
+		"CostCenter":            "1234",
+		"CostCenterOwner":       "ops",
+		"aws:backup:source-arn": "arn:aws:backup:us-west-2:123456789012:recovery-point:1",
+	}, ignore)
+
+	expected := map[string]string{
+		"Name":            "tf-test",
+		"CostCenterOwner": "ops",
+	}
+	if m := tagsToMapRDS(tags, nil); !reflect.DeepEqual(m, expected) {
+		t.Fatalf("bad tags: %#v", m)
+	}
+}
+
+func TestUpdateTagsRDS_ignore(t *testing.T) {
+	conn := rds.New(session.New(&aws.Config{Region: aws.String("us-west-2")}))
+
+	var calls int
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		calls++
+	})
+
+	ignore := &tagIgnoreConfig{
+		Keys:        []string{"CostCenter"},
+		KeyPrefixes: []string{"aws:backup:"},
+	}
+	o := map[string]interface{}{
+		"Name":                  "tf-test",
+		"CostCenter":            "1234",
+		"aws:backup:source-arn": "arn:aws:backup:us-west-2:123456789012:recovery-point:1",
+	}
+	n := map[string]interface{}{
+		"Name": "tf-test",
+	}
+
+	arn := "arn:aws:rds:us-west-2:123456789012:db:tf-test"
+	if err := updateTagsRDS(conn, arn, ignore.filterMap(o), ignore.filterMap(n)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if calls != 0 {
+		t.Fatalf("expected ignored tags to be left alone, got %d API calls", calls)
+	}
+}
+
 // testAccCheckTags can be used to check the tags on a resource.
 func testAccCheckRDSTags(
 	ts []*rds.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMapRDS(ts)
+		m := tagsToMapRDS(ts, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
@@ -92,6 +92,14 @@ func (d *ResourceData) GetOk(key string) (interface{}, bool) {
 	return r.Value, exists
 }
 
+// GetOkExists returns the data for the given key and whether or not the key
+// has been set, even to its zero value. This is mostly useful for Optional,
+// Computed booleans, where GetOk can't tell an explicit false from unset.
+func (d *ResourceData) GetOkExists(key string) (interface{}, bool) {
+	r := d.getRaw(key, getSourceSet)
+	return r.Value, r.Exists && !r.Computed
+}
+
 func (d *ResourceData) getRaw(key string, level getSource) getResult {
 	var parts []string
 	if key != "" {
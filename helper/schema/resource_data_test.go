@@ -1080,6 +1080,100 @@ func TestResourceDataGetOk(t *testing.T) {
 	}
 }
 
+func TestResourceDataGetOkExists(t *testing.T) {
+	cases := []struct {
+		Schema map[string]*Schema
+		State  *terraform.InstanceState
+		Diff   *terraform.InstanceDiff
+		Key    string
+		Value  interface{}
+		Ok     bool
+	}{
+		{
+			Schema: map[string]*Schema{
+				"publicly_accessible": &Schema{
+					Type:     TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+			},
+
+			State: nil,
+
+			Diff: &terraform.InstanceDiff{
+				Attributes: map[string]*terraform.ResourceAttrDiff{
+					"publicly_accessible": &terraform.ResourceAttrDiff{
+						Old: "",
+						New: "false",
+					},
+				},
+			},
+
+			Key:   "publicly_accessible",
+			Value: false,
+			Ok:    true,
+		},
+
+		{
+			Schema: map[string]*Schema{
+				"publicly_accessible": &Schema{
+					Type:     TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+			},
+
+			State: nil,
+
+			Diff: &terraform.InstanceDiff{
+				Attributes: map[string]*terraform.ResourceAttrDiff{
+					"publicly_accessible": &terraform.ResourceAttrDiff{
+						Old:         "",
+						NewComputed: true,
+					},
+				},
+			},
+
+			Key:   "publicly_accessible",
+			Value: false,
+			Ok:    false,
+		},
+
+		{
+			Schema: map[string]*Schema{
+				"publicly_accessible": &Schema{
+					Type:     TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+			},
+
+			State: nil,
+
+			Diff: nil,
+
+			Key:   "publicly_accessible",
+			Value: false,
+			Ok:    false,
+		},
+	}
+
+	for i, tc := range cases {
+		d, err := schemaMap(tc.Schema).Data(tc.State, tc.Diff)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		v, ok := d.GetOkExists(tc.Key)
+		if !reflect.DeepEqual(v, tc.Value) {
+			t.Fatalf("Bad: %d\n\n%#v", i, v)
+		}
+		if ok != tc.Ok {
+			t.Fatalf("%d: expected ok: %t, got: %t", i, tc.Ok, ok)
+		}
+	}
+}
+
 func TestResourceDataHasChange(t *testing.T) {
 	cases := []struct {
 		Schema map[string]*Schema